@@ -0,0 +1,13 @@
+package vpn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/keeper"
+)
+
+// BeginBlocker tracks node liveness every block, jailing and slashing any
+// node whose missed-heartbeat count has crossed the configured threshold.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.TrackMissedHeartbeats(ctx)
+}
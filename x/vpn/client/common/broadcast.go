@@ -0,0 +1,97 @@
+package common
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+)
+
+// CompleteAndBroadcastTx builds a StdTx around msg using the fields on
+// baseReq, signs it with the named key from the local keybase (the
+// passphrase comes from baseReq.Password, never from stdin), and broadcasts
+// it in the mode requested by baseReq. It fills in AccountNumber/Sequence
+// from the chain when the request omits them, closing the gap between the
+// CLI and REST clients for vpn module messages.
+func CompleteAndBroadcastTx(cliCtx context.CLIContext, cdc *codec.Codec, baseReq rest.BaseReq, msg sdk.Msg) (sdk.TxResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	accNumber, sequence, err := fillAccountNumberSequence(
+		baseReq.AccountNumber, baseReq.Sequence,
+		func() (uint64, uint64, error) {
+			return auth.NewAccountRetriever(cliCtx).GetAccountNumberSequence(fromAddr)
+		},
+	)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	txBldr := authtxb.NewTxBuilder(
+		utils.GetTxEncoder(cdc), accNumber, sequence, baseReq.Gas, baseReq.GasAdjustment,
+		baseReq.Simulate, baseReq.ChainID, baseReq.Memo, baseReq.Fees, baseReq.GasPrices,
+	)
+
+	kb, err := keys.NewKeyBaseFromDir(viper.GetString(flags.FlagHome))
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	stdSignMsg, err := txBldr.BuildSignMsg([]sdk.Msg{msg})
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	sig, pubKey, err := kb.Sign(baseReq.Name, baseReq.Password, stdSignMsg.Bytes())
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	stdSig := auth.StdSignature{PubKey: pubKey, Signature: sig}
+	stdTx := auth.NewStdTx(stdSignMsg.Msgs, stdSignMsg.Fee, []auth.StdSignature{stdSig}, stdSignMsg.Memo)
+
+	txBytes, err := cdc.MarshalBinaryLengthPrefixed(stdTx)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	return cliCtx.WithBroadcastMode(baseReq.BroadcastMode).BroadcastTx(txBytes)
+}
+
+// fillAccountNumberSequence fills in accNumber and/or sequence from fetch
+// whenever either is left at its zero value, leaving any value the caller
+// did supply untouched. The two fields are filled independently: a caller
+// that supplies a cached accNumber but omits sequence must still get a
+// freshly fetched sequence, not a zero one.
+func fillAccountNumberSequence(accNumber, sequence uint64, fetch func() (uint64, uint64, error)) (uint64, uint64, error) {
+	if accNumber != 0 && sequence != 0 {
+		return accNumber, sequence, nil
+	}
+
+	fetchedAccNumber, fetchedSequence, err := fetch()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if accNumber == 0 {
+		accNumber = fetchedAccNumber
+	}
+	if sequence == 0 {
+		sequence = fetchedSequence
+	}
+
+	return accNumber, sequence, nil
+}
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFillAccountNumberSequence(t *testing.T) {
+	fetch := func() (uint64, uint64, error) { return 7, 42, nil }
+
+	tests := []struct {
+		name          string
+		accNumber     uint64
+		sequence      uint64
+		wantAccNumber uint64
+		wantSequence  uint64
+	}{
+		{"both supplied, fetch not needed", 1, 2, 1, 2},
+		{"both omitted", 0, 0, 7, 42},
+		{"account number cached, sequence omitted", 1, 0, 1, 42},
+		{"sequence cached, account number omitted", 0, 2, 7, 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			accNumber, sequence, err := fillAccountNumberSequence(tc.accNumber, tc.sequence, fetch)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantAccNumber, accNumber)
+			require.Equal(t, tc.wantSequence, sequence)
+		})
+	}
+}
+
+func TestFillAccountNumberSequence_PropagatesFetchError(t *testing.T) {
+	wantErr := errors.New("boom")
+	fetch := func() (uint64, uint64, error) { return 0, 0, wantErr }
+
+	_, _, err := fillAccountNumberSequence(0, 0, fetch)
+	require.Equal(t, wantErr, err)
+}
+
+func TestFillAccountNumberSequence_DoesNotFetchWhenBothSupplied(t *testing.T) {
+	called := false
+	fetch := func() (uint64, uint64, error) {
+		called = true
+		return 0, 0, nil
+	}
+
+	_, _, err := fillAccountNumberSequence(1, 2, fetch)
+	require.NoError(t, err)
+	require.False(t, called, "fetch must not be called when both account_number and sequence are already set")
+}
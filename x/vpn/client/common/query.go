@@ -0,0 +1,102 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	sdkTypes "github.com/ironman0x7b2/sentinel-sdk/types"
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/querier"
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// QuerySession returns the session identified by id
+func QuerySession(cliCtx context.CLIContext, cdc *codec.Codec, id sdkTypes.ID) (session types.Session, err error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/session/%s", types.QuerierRoute, id.String()), nil)
+	if err != nil {
+		return session, err
+	}
+
+	if err := cdc.UnmarshalJSON(res, &session); err != nil {
+		return session, err
+	}
+
+	return session, nil
+}
+
+// QuerySessionsOfSubscription returns the page of sessions bound to the
+// given subscription matching filter, honoring page/limit pagination.
+func QuerySessionsOfSubscription(cliCtx context.CLIContext, cdc *codec.Codec, id sdkTypes.ID,
+	filter types.SessionsFilter, page, limit int) (result types.SessionsPage, err error) {
+	params := querier.QuerySessionsParams{
+		Page:           page,
+		Limit:          limit,
+		Status:         filter.Status,
+		NodeID:         filter.NodeID,
+		SubscriptionID: id.String(),
+		Subscriber:     filter.Subscriber,
+		StartTimeFrom:  filter.StartTimeFrom,
+		StartTimeTo:    filter.StartTimeTo,
+	}
+
+	return querySessionsFiltered(cliCtx, cdc, params)
+}
+
+// QueryAllSessions returns the page of sessions matching filter, honoring
+// page/limit pagination. It streams results through the vpn module's ABCI
+// querier instead of loading the full session set into memory.
+func QueryAllSessions(cliCtx context.CLIContext, cdc *codec.Codec,
+	filter types.SessionsFilter, page, limit int) (types.SessionsPage, error) {
+	params := querier.QuerySessionsParams{
+		Page:           page,
+		Limit:          limit,
+		Status:         filter.Status,
+		NodeID:         filter.NodeID,
+		SubscriptionID: filter.SubscriptionID,
+		Subscriber:     filter.Subscriber,
+		StartTimeFrom:  filter.StartTimeFrom,
+		StartTimeTo:    filter.StartTimeTo,
+	}
+
+	return querySessionsFiltered(cliCtx, cdc, params)
+}
+
+func querySessionsFiltered(cliCtx context.CLIContext, cdc *codec.Codec,
+	params querier.QuerySessionsParams) (page types.SessionsPage, err error) {
+	data, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return page, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, querier.QuerySessionsFiltered), data)
+	if err != nil {
+		return page, err
+	}
+
+	if err := cdc.UnmarshalJSON(res, &page); err != nil {
+		return page, err
+	}
+
+	return page, nil
+}
+
+// QueryNodeSigningInfo returns the liveness tracking info for the given node
+func QueryNodeSigningInfo(cliCtx context.CLIContext, cdc *codec.Codec, id hub.NodeID) (info types.NodeSigningInfo, err error) {
+	data, err := cdc.MarshalJSON(querier.QueryNodeSigningInfoParams{NodeID: id.String()})
+	if err != nil {
+		return info, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, querier.QueryNodeSigningInfo), data)
+	if err != nil {
+		return info, err
+	}
+
+	if err := cdc.UnmarshalJSON(res, &info); err != nil {
+		return info, err
+	}
+
+	return info, nil
+}
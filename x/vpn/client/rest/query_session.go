@@ -1,17 +1,99 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 
 	sdkTypes "github.com/ironman0x7b2/sentinel-sdk/types"
 	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/client/common"
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
 )
 
+const (
+	defaultPage  = 0
+	defaultLimit = 100
+)
+
+// sessionsEnvelope is the JSON shape returned by the paginated sessions
+// endpoints: the page of results, the total count, and a link to the next
+// page when one exists.
+type sessionsEnvelope struct {
+	Total    int64           `json:"total"`
+	NextPage string          `json:"next_page,omitempty"`
+	Sessions []types.Session `json:"sessions"`
+}
+
+func newSessionsEnvelope(r *http.Request, page, limit int, result types.SessionsPage) sessionsEnvelope {
+	env := sessionsEnvelope{
+		Total:    result.Total,
+		Sessions: result.Sessions,
+	}
+
+	if int64((page+1)*limit) < result.Total {
+		query := r.URL.Query()
+		query.Set("page", strconv.Itoa(page+1))
+		env.NextPage = fmt.Sprintf("%s?%s", r.URL.Path, query.Encode())
+	}
+
+	return env
+}
+
+func parsePageAndLimit(r *http.Request) (page, limit int, err error) {
+	page, limit = defaultPage, defaultLimit
+
+	if v := r.URL.Query().Get("page"); v != "" {
+		if page, err = strconv.Atoi(v); err != nil {
+			return 0, 0, fmt.Errorf("invalid page: %s", v)
+		}
+	}
+	if v := r.URL.Query().Get("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil {
+			return 0, 0, fmt.Errorf("invalid limit: %s", v)
+		}
+	}
+	if limit <= 0 {
+		return 0, 0, fmt.Errorf("invalid limit: %d, must be positive", limit)
+	}
+
+	return page, limit, nil
+}
+
+func parseSessionsFilter(r *http.Request) (types.SessionsFilter, error) {
+	var filter types.SessionsFilter
+
+	query := r.URL.Query()
+	filter.Status = query.Get("status")
+	filter.NodeID = query.Get("node_id")
+	filter.SubscriptionID = query.Get("subscription_id")
+	filter.Subscriber = query.Get("subscriber")
+
+	if v := query.Get("start_time_from"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, fmt.Errorf("invalid start_time_from: %s", v)
+		}
+		filter.StartTimeFrom = t
+	}
+	if v := query.Get("start_time_to"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, fmt.Errorf("invalid start_time_to: %s", v)
+		}
+		filter.StartTimeTo = t
+	}
+
+	return filter, nil
+}
+
 func getSessionHandlerFunc(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -31,25 +113,110 @@ func getSessionsOfSubscriptionHandlerFunc(cliCtx context.CLIContext, cdc *codec.
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 
+		page, limit, err := parsePageAndLimit(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		filter, err := parseSessionsFilter(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		id := sdkTypes.NewIDFromString(vars["subscriptionID"])
-		sessions, err := common.QuerySessionsOfSubscription(cliCtx, cdc, id)
+		result, err := common.QuerySessionsOfSubscription(cliCtx, cdc, id, filter, page, limit)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		rest.PostProcessResponse(w, cdc, sessions, cliCtx.Indent)
+		rest.PostProcessResponse(w, cdc, newSessionsEnvelope(r, page, limit, result), cliCtx.Indent)
 	}
 }
 
 func getAllSessionsHandlerFunc(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		sessions, err := common.QueryAllSessions(cliCtx, cdc)
+		page, limit, err := parsePageAndLimit(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		filter, err := parseSessionsFilter(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		result, err := common.QueryAllSessions(cliCtx, cdc, filter, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, newSessionsEnvelope(r, page, limit, result), cliCtx.Indent)
+	}
+}
+
+func getNodeSigningInfoHandlerFunc(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		id, err := types.ParseNodeID(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		info, err := common.QueryNodeSigningInfo(cliCtx, cdc, id)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		rest.PostProcessResponse(w, cdc, sessions, cliCtx.Indent)
+		rest.PostProcessResponse(w, cdc, info, cliCtx.Indent)
+	}
+}
+
+// unjailNodeReq is the body expected by POST /nodes/{id}/unjail
+type unjailNodeReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func unjailNodeHandlerFunc(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		var req unjailNodeReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		id, err := types.ParseNodeID(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUnjailNode(fromAddr, id)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes registers the vpn module's REST handlers onto r
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc("/vpn/sessions/{sessionID}", getSessionHandlerFunc(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/vpn/subscriptions/{subscriptionID}/sessions", getSessionsOfSubscriptionHandlerFunc(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/vpn/sessions", getAllSessionsHandlerFunc(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/vpn/sessions", startSessionHandlerFunc(cliCtx, cdc)).Methods("POST")
+	r.HandleFunc("/vpn/sessions/{sessionID}/end", endSessionHandlerFunc(cliCtx, cdc)).Methods("POST")
+
+	r.HandleFunc("/vpn/nodes", registerNodeHandlerFunc(cliCtx, cdc)).Methods("POST")
+	r.HandleFunc("/vpn/nodes/{id}", updateNodeInfoHandlerFunc(cliCtx, cdc)).Methods("PUT")
+	r.HandleFunc("/vpn/nodes/{id}", deregisterNodeHandlerFunc(cliCtx, cdc)).Methods("DELETE")
+	r.HandleFunc("/vpn/nodes/{id}/signing-info", getNodeSigningInfoHandlerFunc(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/vpn/nodes/{id}/unjail", unjailNodeHandlerFunc(cliCtx, cdc)).Methods("POST")
+}
@@ -0,0 +1,162 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/client/common"
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+	hub "github.com/sentinel-official/hub/types"
+)
+
+type registerNodeReq struct {
+	BaseReq       rest.BaseReq  `json:"base_req"`
+	Type          string        `json:"type"`
+	Version       string        `json:"version"`
+	Moniker       string        `json:"moniker"`
+	PricesPerGB   sdk.Coins     `json:"prices_per_gb"`
+	Deposit       sdk.Coins     `json:"deposit"`
+	InternetSpeed hub.Bandwidth `json:"internet_speed"`
+	Encryption    string        `json:"encryption"`
+}
+
+func registerNodeHandlerFunc(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req registerNodeReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRegisterNode(fromAddr, req.Type, req.Version, req.Moniker, req.PricesPerGB, req.Deposit, req.InternetSpeed, req.Encryption)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.CompleteAndBroadcastTx(cliCtx, cdc, baseReq, msg)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// updateNodeInfoReq mirrors MsgUpdateNodeInfo's optional fields: a field
+// omitted from the request body leaves the node's existing value unchanged,
+// while an explicit value overwrites it. An empty moniker clears it; an empty
+// type, version or encryption fails validation.
+type updateNodeInfoReq struct {
+	BaseReq       rest.BaseReq   `json:"base_req"`
+	Type          *string        `json:"type,omitempty"`
+	Version       *string        `json:"version,omitempty"`
+	Moniker       *string        `json:"moniker,omitempty"`
+	PricesPerGB   *sdk.Coins     `json:"prices_per_gb,omitempty"`
+	InternetSpeed *hub.Bandwidth `json:"internet_speed,omitempty"`
+	Encryption    *string        `json:"encryption,omitempty"`
+}
+
+func updateNodeInfoHandlerFunc(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		var req updateNodeInfoReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		id, err := types.ParseNodeID(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUpdateNodeInfo(fromAddr, id, req.Type, req.Version, req.Moniker, req.PricesPerGB, req.InternetSpeed, req.Encryption)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.CompleteAndBroadcastTx(cliCtx, cdc, baseReq, msg)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+type deregisterNodeReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func deregisterNodeHandlerFunc(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		var req deregisterNodeReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		id, err := types.ParseNodeID(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgDeregisterNode(fromAddr, id)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.CompleteAndBroadcastTx(cliCtx, cdc, baseReq, msg)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
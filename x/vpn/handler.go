@@ -0,0 +1,153 @@
+package vpn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/keeper"
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+)
+
+// NewHandler returns a handler for all vpn module messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case types.MsgRegisterNode:
+			return handleMsgRegisterNode(ctx, k, msg)
+		case types.MsgUpdateNodeInfo:
+			return handleMsgUpdateNodeInfo(ctx, k, msg)
+		case types.MsgDeregisterNode:
+			return handleMsgDeregisterNode(ctx, k, msg)
+		case types.MsgHeartbeat:
+			return handleMsgHeartbeat(ctx, k, msg)
+		case types.MsgUnjailNode:
+			return handleMsgUnjailNode(ctx, k, msg)
+		case types.MsgStartSession:
+			return handleMsgStartSession(ctx, k, msg)
+		case types.MsgEndSession:
+			return handleMsgEndSession(ctx, k, msg)
+		default:
+			return sdk.ErrUnknownRequest("unrecognized vpn message type").Result()
+		}
+	}
+}
+
+func handleMsgRegisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgRegisterNode) sdk.Result {
+	node := types.Node{
+		ID:            k.NextNodeID(ctx),
+		Owner:         msg.From,
+		Type:          msg.NodeType,
+		Version:       msg.Version,
+		Moniker:       msg.Moniker,
+		PricesPerGB:   msg.PricesPerGB,
+		InternetSpeed: msg.InternetSpeed,
+		Encryption:    msg.Encryption,
+		Deposit:       msg.Deposit,
+		Status:        types.NodeStatusActive,
+	}
+
+	k.SetNode(ctx, node)
+	k.SetNodeSigningInfo(ctx, types.NodeSigningInfo{NodeID: node.ID, StartHeight: ctx.BlockHeight()})
+
+	return sdk.Result{}
+}
+
+func handleMsgUpdateNodeInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateNodeInfo) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorInvalidField("id").Result()
+	}
+	if !node.Owner.Equals(msg.From) {
+		return types.ErrorInvalidField("from").Result()
+	}
+
+	if msg.NodeType != nil {
+		node.Type = *msg.NodeType
+	}
+	if msg.Version != nil {
+		node.Version = *msg.Version
+	}
+	if msg.Moniker != nil {
+		node.Moniker = *msg.Moniker
+	}
+	if msg.PricesPerGB != nil {
+		node.PricesPerGB = *msg.PricesPerGB
+	}
+	if msg.InternetSpeed != nil {
+		node.InternetSpeed = *msg.InternetSpeed
+	}
+	if msg.Encryption != nil {
+		node.Encryption = *msg.Encryption
+	}
+
+	k.SetNode(ctx, node)
+
+	return sdk.Result{}
+}
+
+func handleMsgDeregisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgDeregisterNode) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorInvalidField("id").Result()
+	}
+	if !node.Owner.Equals(msg.From) {
+		return types.ErrorInvalidField("from").Result()
+	}
+
+	k.DeleteNode(ctx, msg.ID)
+
+	return sdk.Result{}
+}
+
+func handleMsgHeartbeat(ctx sdk.Context, k keeper.Keeper, msg types.MsgHeartbeat) sdk.Result {
+	if err := k.HandleHeartbeat(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{}
+}
+
+func handleMsgUnjailNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnjailNode) sdk.Result {
+	if err := k.HandleUnjailNode(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{}
+}
+
+func handleMsgStartSession(ctx sdk.Context, k keeper.Keeper, msg types.MsgStartSession) sdk.Result {
+	node, found := k.GetNode(ctx, msg.NodeID)
+	if !found {
+		return types.ErrorInvalidField("node_id").Result()
+	}
+	if node.Status != types.NodeStatusActive {
+		return types.ErrorInvalidField("node_id").Result()
+	}
+
+	session := types.Session{
+		ID:         k.NextSessionID(ctx),
+		NodeID:     msg.NodeID,
+		Subscriber: msg.From,
+		Status:     types.StatusActive,
+		StartTime:  ctx.BlockTime(),
+	}
+
+	k.SetSession(ctx, session)
+
+	return sdk.Result{}
+}
+
+func handleMsgEndSession(ctx sdk.Context, k keeper.Keeper, msg types.MsgEndSession) sdk.Result {
+	session, found := k.GetSession(ctx, msg.SessionID)
+	if !found {
+		return types.ErrorInvalidField("session_id").Result()
+	}
+	if !session.Subscriber.Equals(msg.From) {
+		return types.ErrorInvalidField("from").Result()
+	}
+
+	session.Status = types.StatusEnded
+	session.EndTime = ctx.BlockTime()
+	k.SetSession(ctx, session)
+
+	return sdk.Result{}
+}
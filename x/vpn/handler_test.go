@@ -0,0 +1,120 @@
+package vpn
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/keeper"
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func setupTestKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, tParamsKey, params.DefaultCodespace)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	return ctx, keeper.NewKeeper(cdc, storeKey, paramsKeeper.Subspace(types.ModuleName))
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestHandleMsgUpdateNodeInfo_MergesOnlyProvidedFields(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	node := types.Node{
+		ID:            hub.NewNodeID(1),
+		Owner:         owner,
+		Type:          "wireguard",
+		Version:       "v1",
+		Moniker:       "original",
+		PricesPerGB:   sdk.Coins{sdk.NewInt64Coin("stake", 100)},
+		InternetSpeed: hub.NewBandwidth(sdk.NewInt(10), sdk.NewInt(10)),
+		Encryption:    "aes",
+		Status:        types.NodeStatusActive,
+	}
+	k.SetNode(ctx, node)
+
+	msg := types.MsgUpdateNodeInfo{
+		From:    owner,
+		ID:      node.ID,
+		Moniker: strPtr("updated"),
+	}
+
+	result := handleMsgUpdateNodeInfo(ctx, k, msg)
+	require.True(t, result.IsOK())
+
+	got, found := k.GetNode(ctx, node.ID)
+	require.True(t, found)
+	require.Equal(t, "updated", got.Moniker)
+	require.Equal(t, node.Type, got.Type)
+	require.Equal(t, node.Version, got.Version)
+	require.Equal(t, node.PricesPerGB, got.PricesPerGB)
+	require.Equal(t, node.InternetSpeed, got.InternetSpeed)
+	require.Equal(t, node.Encryption, got.Encryption)
+}
+
+func TestHandleMsgUpdateNodeInfo_ClearsMonikerOnEmptyPointer(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	node := types.Node{ID: hub.NewNodeID(1), Owner: owner, Moniker: "original", Status: types.NodeStatusActive}
+	k.SetNode(ctx, node)
+
+	msg := types.MsgUpdateNodeInfo{From: owner, ID: node.ID, Moniker: strPtr("")}
+
+	result := handleMsgUpdateNodeInfo(ctx, k, msg)
+	require.True(t, result.IsOK())
+
+	got, found := k.GetNode(ctx, node.ID)
+	require.True(t, found)
+	require.Equal(t, "", got.Moniker)
+}
+
+func TestHandleMsgUpdateNodeInfo_RejectsWrongOwner(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	other := sdk.AccAddress([]byte("someone_else________"))
+	node := types.Node{ID: hub.NewNodeID(1), Owner: owner, Status: types.NodeStatusActive}
+	k.SetNode(ctx, node)
+
+	msg := types.MsgUpdateNodeInfo{From: other, ID: node.ID, Moniker: strPtr("updated")}
+
+	result := handleMsgUpdateNodeInfo(ctx, k, msg)
+	require.False(t, result.IsOK())
+
+	got, found := k.GetNode(ctx, node.ID)
+	require.True(t, found)
+	require.Equal(t, node.Moniker, got.Moniker)
+}
+
+func TestHandleMsgUpdateNodeInfo_RejectsUnknownNode(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	msg := types.MsgUpdateNodeInfo{From: owner, ID: hub.NewNodeID(404), Moniker: strPtr("updated")}
+
+	result := handleMsgUpdateNodeInfo(ctx, k, msg)
+	require.False(t, result.IsOK())
+}
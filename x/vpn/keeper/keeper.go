@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+)
+
+// Keeper of the vpn store
+type Keeper struct {
+	cdc        *codec.Codec
+	key        sdk.StoreKey
+	paramSpace params.Subspace
+}
+
+// NewKeeper creates a new vpn Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		key:        key,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// GetParams returns the vpn module's parameters
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the vpn module's parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
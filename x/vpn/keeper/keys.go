@@ -0,0 +1,9 @@
+package keeper
+
+// SessionsKeyPrefix is the prefix under which every session is stored
+var SessionsKeyPrefix = []byte{0x01}
+
+// SessionKey returns the store key for the session identified by id
+func SessionKey(id []byte) []byte {
+	return append(SessionsKeyPrefix, id...)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+)
+
+// NodesKeyPrefix is the prefix under which every node is stored
+var NodesKeyPrefix = []byte{0x02}
+
+// NodeCountKey is the key under which the running node counter is stored
+var NodeCountKey = []byte{0x04}
+
+// NextNodeID returns the next unused node ID and advances the counter
+func (k Keeper) NextNodeID(ctx sdk.Context) hub.NodeID {
+	store := ctx.KVStore(k.key)
+
+	var count uint64
+	if value := store.Get(NodeCountKey); value != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &count)
+	}
+
+	store.Set(NodeCountKey, k.cdc.MustMarshalBinaryLengthPrefixed(count+1))
+
+	return hub.NewNodeID(count)
+}
+
+// NodeKey returns the store key for the node identified by id
+func NodeKey(id hub.NodeID) []byte {
+	return append(NodesKeyPrefix, []byte(id.String())...)
+}
+
+func (k Keeper) GetNode(ctx sdk.Context, id hub.NodeID) (node types.Node, found bool) {
+	store := ctx.KVStore(k.key)
+
+	value := store.Get(NodeKey(id))
+	if value == nil {
+		return node, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &node)
+	return node, true
+}
+
+func (k Keeper) SetNode(ctx sdk.Context, node types.Node) {
+	store := ctx.KVStore(k.key)
+	store.Set(NodeKey(node.ID), k.cdc.MustMarshalBinaryLengthPrefixed(node))
+}
+
+func (k Keeper) DeleteNode(ctx sdk.Context, id hub.NodeID) {
+	store := ctx.KVStore(k.key)
+	store.Delete(NodeKey(id))
+}
+
+// IterateNodes walks every registered node in the store
+func (k Keeper) IterateNodes(ctx sdk.Context, cb func(index int64, node types.Node) (stop bool)) {
+	store := ctx.KVStore(k.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, NodesKeyPrefix)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var node types.Node
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &node)
+
+		if cb(i, node) {
+			break
+		}
+		i++
+	}
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkTypes "github.com/ironman0x7b2/sentinel-sdk/types"
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+)
+
+// SessionCountKey is the key under which the running session counter is stored
+var SessionCountKey = []byte{0x05}
+
+// NextSessionID returns the next unused session ID and advances the counter
+func (k Keeper) NextSessionID(ctx sdk.Context) sdkTypes.ID {
+	store := ctx.KVStore(k.key)
+
+	var count int64
+	if value := store.Get(SessionCountKey); value != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &count)
+	}
+
+	store.Set(SessionCountKey, k.cdc.MustMarshalBinaryLengthPrefixed(count+1))
+
+	return sdkTypes.NewIDFromString(strconv.FormatInt(count, 10))
+}
+
+// sessionIDKeyBytes zero-pads a session ID's decimal value to a fixed width,
+// so that store keys sort in creation order rather than in the
+// byte-lexicographic order of their unpadded decimal string (which diverges
+// once ids reach 10+).
+func sessionIDKeyBytes(id sdkTypes.ID) []byte {
+	n, err := strconv.ParseInt(id.String(), 10, 64)
+	if err != nil {
+		return []byte(id.String())
+	}
+
+	return []byte(fmt.Sprintf("%020d", n))
+}
+
+func (k Keeper) GetSession(ctx sdk.Context, id sdkTypes.ID) (session types.Session, found bool) {
+	store := ctx.KVStore(k.key)
+
+	value := store.Get(SessionKey(sessionIDKeyBytes(id)))
+	if value == nil {
+		return session, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &session)
+	return session, true
+}
+
+func (k Keeper) SetSession(ctx sdk.Context, session types.Session) {
+	store := ctx.KVStore(k.key)
+	store.Set(SessionKey(sessionIDKeyBytes(session.ID)), k.cdc.MustMarshalBinaryLengthPrefixed(session))
+}
+
+// IterateSessions walks every session in the store, in creation order,
+// applying filter and offset/limit before invoking cb for each match.
+// limit must be a positive number of results to return; iteration stops
+// early once limit matches are visited or if cb returns true.
+func (k Keeper) IterateSessions(ctx sdk.Context, filter types.SessionsFilter, offset, limit int, cb func(index int64, session types.Session) (stop bool)) {
+	store := ctx.KVStore(k.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, SessionsKeyPrefix)
+	defer iterator.Close()
+
+	var matched, visited int64
+	for ; iterator.Valid(); iterator.Next() {
+		var session types.Session
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &session)
+
+		if !filter.Match(session) {
+			continue
+		}
+
+		if int(matched) < offset {
+			matched++
+			continue
+		}
+		if visited >= int64(limit) {
+			break
+		}
+
+		if cb(visited, session) {
+			break
+		}
+
+		matched++
+		visited++
+	}
+}
+
+// CountSessions returns the number of sessions matching filter, without
+// holding the matching set in memory.
+func (k Keeper) CountSessions(ctx sdk.Context, filter types.SessionsFilter) (count int64) {
+	store := ctx.KVStore(k.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, SessionsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var session types.Session
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &session)
+
+		if filter.Match(session) {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+)
+
+func TestKeeper_IterateSessions_OffsetAndLimit(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	const total = 12
+	for i := 0; i < total; i++ {
+		k.SetSession(ctx, types.Session{ID: k.NextSessionID(ctx), Status: types.StatusActive})
+	}
+
+	tests := []struct {
+		name       string
+		offset     int
+		limit      int
+		wantVisits int
+		wantFirst  string
+	}{
+		{"first page", 0, 5, 5, "0"},
+		{"second page", 5, 5, 5, "5"},
+		{"last, short page", 10, 5, 2, "10"},
+		{"offset past the end", total, 5, 0, ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var ids []string
+			k.IterateSessions(ctx, types.SessionsFilter{}, tc.offset, tc.limit, func(_ int64, session types.Session) bool {
+				ids = append(ids, session.ID.String())
+				return false
+			})
+
+			require.Len(t, ids, tc.wantVisits)
+			if tc.wantFirst != "" {
+				require.Equal(t, tc.wantFirst, ids[0])
+			}
+		})
+	}
+}
+
+func TestKeeper_IterateSessions_SortsByCreationOrderNotByteOrder(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	const total = 11
+	for i := 0; i < total; i++ {
+		k.SetSession(ctx, types.Session{ID: k.NextSessionID(ctx), Status: types.StatusActive})
+	}
+
+	var ids []string
+	k.IterateSessions(ctx, types.SessionsFilter{}, 0, total, func(_ int64, session types.Session) bool {
+		ids = append(ids, session.ID.String())
+		return false
+	})
+
+	require.Equal(t, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10"}, ids)
+}
+
+func TestKeeper_CountSessions_FiltersBeforeCounting(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	k.SetSession(ctx, types.Session{ID: k.NextSessionID(ctx), Status: types.StatusActive})
+	k.SetSession(ctx, types.Session{ID: k.NextSessionID(ctx), Status: types.StatusEnded})
+	k.SetSession(ctx, types.Session{ID: k.NextSessionID(ctx), Status: types.StatusActive})
+
+	require.EqualValues(t, 3, k.CountSessions(ctx, types.SessionsFilter{}))
+	require.EqualValues(t, 2, k.CountSessions(ctx, types.SessionsFilter{Status: types.StatusActive}))
+	require.EqualValues(t, 1, k.CountSessions(ctx, types.SessionsFilter{Status: types.StatusEnded}))
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+)
+
+// SigningInfosKeyPrefix is the prefix under which every NodeSigningInfo is stored
+var SigningInfosKeyPrefix = []byte{0x03}
+
+// SigningInfoKey returns the store key for the signing info of node id
+func SigningInfoKey(id hub.NodeID) []byte {
+	return append(SigningInfosKeyPrefix, []byte(id.String())...)
+}
+
+func (k Keeper) GetNodeSigningInfo(ctx sdk.Context, id hub.NodeID) (info types.NodeSigningInfo, found bool) {
+	store := ctx.KVStore(k.key)
+
+	value := store.Get(SigningInfoKey(id))
+	if value == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &info)
+	return info, true
+}
+
+func (k Keeper) SetNodeSigningInfo(ctx sdk.Context, info types.NodeSigningInfo) {
+	store := ctx.KVStore(k.key)
+	store.Set(SigningInfoKey(info.NodeID), k.cdc.MustMarshalBinaryLengthPrefixed(info))
+}
+
+// IterateNodeSigningInfos walks every tracked signing info in the store
+func (k Keeper) IterateNodeSigningInfos(ctx sdk.Context, cb func(index int64, info types.NodeSigningInfo) (stop bool)) {
+	store := ctx.KVStore(k.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, SigningInfosKeyPrefix)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var info types.NodeSigningInfo
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &info)
+
+		if cb(i, info) {
+			break
+		}
+		i++
+	}
+}
+
+// HandleHeartbeat resets the missed-heartbeat counter for the node operated
+// by msg.From. The node must exist and be owned by the sender.
+func (k Keeper) HandleHeartbeat(ctx sdk.Context, msg types.MsgHeartbeat) sdk.Error {
+	node, found := k.GetNode(ctx, msg.NodeID)
+	if !found {
+		return types.ErrorInvalidField("node_id")
+	}
+	if !node.Owner.Equals(msg.From) {
+		return types.ErrorInvalidField("from")
+	}
+
+	info, found := k.GetNodeSigningInfo(ctx, msg.NodeID)
+	if !found {
+		info = types.NodeSigningInfo{NodeID: msg.NodeID, StartHeight: ctx.BlockHeight()}
+	}
+
+	info.MissedHeartbeats = 0
+	k.SetNodeSigningInfo(ctx, info)
+
+	return nil
+}
+
+// HandleUnjailNode releases a node from jail once its jail term has elapsed
+func (k Keeper) HandleUnjailNode(ctx sdk.Context, msg types.MsgUnjailNode) sdk.Error {
+	node, found := k.GetNode(ctx, msg.NodeID)
+	if !found {
+		return types.ErrorInvalidField("node_id")
+	}
+	if !node.Owner.Equals(msg.From) {
+		return types.ErrorInvalidField("from")
+	}
+	if node.Status != types.NodeStatusJailed {
+		return types.ErrorInvalidField("node_id")
+	}
+
+	info, found := k.GetNodeSigningInfo(ctx, msg.NodeID)
+	if found && info.IsJailed(ctx.BlockTime()) {
+		return types.ErrorInvalidField("node_id")
+	}
+
+	node.Status = types.NodeStatusActive
+	k.SetNode(ctx, node)
+
+	if found {
+		info.MissedHeartbeats = 0
+		k.SetNodeSigningInfo(ctx, info)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+)
+
+// slashNode cuts a node's deposit by fraction and marks it jailed
+func (k Keeper) slashNode(ctx sdk.Context, node types.Node, fraction sdk.Dec) {
+	slashed := make(sdk.Coins, len(node.Deposit))
+	for i, coin := range node.Deposit {
+		cut := sdk.NewDecFromInt(coin.Amount).Mul(fraction).TruncateInt()
+		slashed[i] = sdk.NewCoin(coin.Denom, coin.Amount.Sub(cut))
+	}
+
+	node.Deposit = slashed
+	node.Status = types.NodeStatusJailed
+
+	k.SetNode(ctx, node)
+}
+
+// TrackMissedHeartbeats increments the missed-heartbeat counter for every
+// active node and jails/slashes any node that has crossed the threshold.
+// Intended to be called once per block from the BeginBlocker.
+func (k Keeper) TrackMissedHeartbeats(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	k.IterateNodes(ctx, func(_ int64, node types.Node) bool {
+		if node.Status != types.NodeStatusActive {
+			return false
+		}
+
+		info, found := k.GetNodeSigningInfo(ctx, node.ID)
+		if !found {
+			info = types.NodeSigningInfo{NodeID: node.ID, StartHeight: ctx.BlockHeight()}
+		}
+
+		if ctx.BlockHeight()-info.StartHeight < params.MissedHeartbeatWindow {
+			return false
+		}
+
+		info.MissedHeartbeats++
+
+		if info.MissedHeartbeats > params.MissedHeartbeatsToJail {
+			info.JailedUntil = ctx.BlockTime().Add(params.HeartbeatJailDuration)
+			k.slashNode(ctx, node, params.SlashFractionDowntime)
+		}
+
+		k.SetNodeSigningInfo(ctx, info)
+		return false
+	})
+}
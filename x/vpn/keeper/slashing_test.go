@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+)
+
+func TestKeeper_TrackMissedHeartbeats_JailsAndSlashesPastThreshold(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.MissedHeartbeatWindow = 1
+	params.MissedHeartbeatsToJail = 1
+	params.SlashFractionDowntime = sdk.NewDecWithPrec(1, 1) // 10%
+	k.SetParams(ctx, params)
+
+	node := types.Node{
+		ID:      k.NextNodeID(ctx),
+		Status:  types.NodeStatusActive,
+		Deposit: sdk.Coins{sdk.NewInt64Coin("stake", 1000)},
+	}
+	k.SetNode(ctx, node)
+	k.SetNodeSigningInfo(ctx, types.NodeSigningInfo{NodeID: node.ID, StartHeight: 0})
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(0, 0))
+	k.TrackMissedHeartbeats(ctx)
+
+	got, found := k.GetNode(ctx, node.ID)
+	require.True(t, found)
+	require.Equal(t, types.NodeStatusJailed, got.Status)
+	require.Equal(t, sdk.NewInt(900), got.Deposit.AmountOf("stake"))
+
+	info, found := k.GetNodeSigningInfo(ctx, node.ID)
+	require.True(t, found)
+	require.Equal(t, int64(2), info.MissedHeartbeats)
+	require.True(t, info.JailedUntil.After(ctx.BlockTime()))
+}
+
+func TestKeeper_TrackMissedHeartbeats_SkipsNodesWithinTheWindow(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.MissedHeartbeatWindow = 100
+	k.SetParams(ctx, params)
+
+	node := types.Node{
+		ID:      k.NextNodeID(ctx),
+		Status:  types.NodeStatusActive,
+		Deposit: sdk.Coins{sdk.NewInt64Coin("stake", 1000)},
+	}
+	k.SetNode(ctx, node)
+	k.SetNodeSigningInfo(ctx, types.NodeSigningInfo{NodeID: node.ID, StartHeight: 0})
+
+	ctx = ctx.WithBlockHeight(10)
+	k.TrackMissedHeartbeats(ctx)
+
+	got, found := k.GetNode(ctx, node.ID)
+	require.True(t, found)
+	require.Equal(t, types.NodeStatusActive, got.Status)
+
+	info, found := k.GetNodeSigningInfo(ctx, node.ID)
+	require.True(t, found)
+	require.Equal(t, int64(0), info.MissedHeartbeats)
+}
+
+func TestKeeper_TrackMissedHeartbeats_SkipsJailedNodes(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.MissedHeartbeatWindow = 1
+	params.MissedHeartbeatsToJail = 1
+	k.SetParams(ctx, params)
+
+	node := types.Node{
+		ID:      k.NextNodeID(ctx),
+		Status:  types.NodeStatusJailed,
+		Deposit: sdk.Coins{sdk.NewInt64Coin("stake", 1000)},
+	}
+	k.SetNode(ctx, node)
+
+	ctx = ctx.WithBlockHeight(10)
+	k.TrackMissedHeartbeats(ctx)
+
+	_, found := k.GetNodeSigningInfo(ctx, node.ID)
+	require.False(t, found)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+)
+
+// setupTestKeeper mounts an in-memory vpn store and returns a ready-to-use
+// Keeper and Context, with the module's default params already set.
+func setupTestKeeper(t *testing.T) (sdk.Context, Keeper) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, tParamsKey, params.DefaultCodespace)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	k := NewKeeper(cdc, storeKey, paramsKeeper.Subspace(types.ModuleName))
+	k.SetParams(ctx, types.DefaultParams())
+
+	return ctx, k
+}
@@ -0,0 +1,118 @@
+package querier
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/keeper"
+	"github.com/ironman0x7b2/sentinel-sdk/x/vpn/types"
+)
+
+// Querier paths
+const (
+	QuerySessionsFiltered = "sessionsFiltered"
+	QueryNodeSigningInfo  = "nodeSigningInfo"
+)
+
+// QueryNodeSigningInfoParams defines the parameters accepted by the
+// QueryNodeSigningInfo path
+type QueryNodeSigningInfoParams struct {
+	NodeID string `json:"node_id"`
+}
+
+// QuerySessionsParams defines the parameters accepted by the
+// QuerySessionsFiltered path, mirroring the REST layer's query parameters.
+type QuerySessionsParams struct {
+	Page           int       `json:"page"`
+	Limit          int       `json:"limit"`
+	Status         string    `json:"status"`
+	NodeID         string    `json:"node_id"`
+	SubscriptionID string    `json:"subscription_id"`
+	Subscriber     string    `json:"subscriber"`
+	StartTimeFrom  time.Time `json:"start_time_from"`
+	StartTimeTo    time.Time `json:"start_time_to"`
+}
+
+// NewQuerier returns the module's ABCI querier, handling the
+// QuerySessionsFiltered and QueryNodeSigningInfo paths. Any other path,
+// including the session/<id> path queried by client/common.QuerySession,
+// returns an unknown-request error.
+func NewQuerier(k keeper.Keeper, cdc *codec.Codec) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QuerySessionsFiltered:
+			return querySessionsFiltered(ctx, req, k, cdc)
+		case QueryNodeSigningInfo:
+			return queryNodeSigningInfo(ctx, req, k, cdc)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown vpn query endpoint")
+		}
+	}
+}
+
+func querySessionsFiltered(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper, cdc *codec.Codec) ([]byte, sdk.Error) {
+	var params QuerySessionsParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	if params.Limit <= 0 {
+		return nil, types.ErrorInvalidField("limit")
+	}
+
+	filter := types.SessionsFilter{
+		Status:         params.Status,
+		NodeID:         params.NodeID,
+		SubscriptionID: params.SubscriptionID,
+		Subscriber:     params.Subscriber,
+		StartTimeFrom:  params.StartTimeFrom,
+		StartTimeTo:    params.StartTimeTo,
+	}
+
+	offset := params.Page * params.Limit
+
+	var sessions []types.Session
+	k.IterateSessions(ctx, filter, offset, params.Limit, func(_ int64, session types.Session) bool {
+		sessions = append(sessions, session)
+		return false
+	})
+
+	result := types.SessionsPage{
+		Total:    k.CountSessions(ctx, filter),
+		Sessions: sessions,
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, result)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryNodeSigningInfo(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper, cdc *codec.Codec) ([]byte, sdk.Error) {
+	var params QueryNodeSigningInfoParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	nodeID, err := types.ParseNodeID(params.NodeID)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	info, found := k.GetNodeSigningInfo(ctx, nodeID)
+	if !found {
+		return nil, types.ErrorInvalidField("node_id")
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, info)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	return bz, nil
+}
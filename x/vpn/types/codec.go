@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc is the codec used to produce canonical, sorted-key JSON for
+// signing. All vpn Msg types must be registered here so that GetSignBytes
+// yields deterministic output regardless of Go struct field order.
+var ModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+}
+
+// RegisterCodec registers the vpn module's Msg types with the given codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgRegisterNode{}, "vpn/MsgRegisterNode", nil)
+	cdc.RegisterConcrete(MsgUpdateNodeInfo{}, "vpn/MsgUpdateNodeInfo", nil)
+	cdc.RegisterConcrete(MsgDeregisterNode{}, "vpn/MsgDeregisterNode", nil)
+	cdc.RegisterConcrete(MsgHeartbeat{}, "vpn/MsgHeartbeat", nil)
+	cdc.RegisterConcrete(MsgUnjailNode{}, "vpn/MsgUnjailNode", nil)
+	cdc.RegisterConcrete(MsgStartSession{}, "vpn/MsgStartSession", nil)
+	cdc.RegisterConcrete(MsgEndSession{}, "vpn/MsgEndSession", nil)
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCodespace is the codespace for all errors raised by the vpn module
+const DefaultCodespace sdk.CodespaceType = ModuleName
+
+// vpn module error codes
+const (
+	CodeInvalidField sdk.CodeType = 101
+)
+
+// ErrorInvalidField returns a standardized error for a missing or malformed message field
+func ErrorInvalidField(field string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidField, fmt.Sprintf("invalid field %s", field))
+}
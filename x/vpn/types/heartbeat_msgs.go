@@ -0,0 +1,90 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+var (
+	_ sdk.Msg = (*MsgHeartbeat)(nil)
+	_ sdk.Msg = (*MsgUnjailNode)(nil)
+)
+
+// MsgHeartbeat is submitted by a node operator to prove liveness and reset
+// its missed-heartbeat counter
+type MsgHeartbeat struct {
+	From           sdk.AccAddress `json:"from"`
+	NodeID         hub.NodeID     `json:"node_id"`
+	Uptime         int64          `json:"uptime"`
+	SessionsServed int64          `json:"sessions_served"`
+}
+
+// NewMsgHeartbeat returns a new MsgHeartbeat
+func NewMsgHeartbeat(from sdk.AccAddress, nodeID hub.NodeID, uptime, sessionsServed int64) *MsgHeartbeat {
+	return &MsgHeartbeat{
+		From:           from,
+		NodeID:         nodeID,
+		Uptime:         uptime,
+		SessionsServed: sessionsServed,
+	}
+}
+
+func (m MsgHeartbeat) Route() string { return RouterKey }
+func (m MsgHeartbeat) Type() string  { return "heartbeat" }
+
+func (m MsgHeartbeat) ValidateBasic() sdk.Error {
+	if m.From == nil || m.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if m.Uptime < 0 {
+		return ErrorInvalidField("uptime")
+	}
+	if m.SessionsServed < 0 {
+		return ErrorInvalidField("sessions_served")
+	}
+
+	return nil
+}
+
+func (m MsgHeartbeat) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgHeartbeat) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.From}
+}
+
+// MsgUnjailNode allows a node operator to request their jailed node be
+// returned to service once the jail term has elapsed
+type MsgUnjailNode struct {
+	From   sdk.AccAddress `json:"from"`
+	NodeID hub.NodeID     `json:"node_id"`
+}
+
+// NewMsgUnjailNode returns a new MsgUnjailNode
+func NewMsgUnjailNode(from sdk.AccAddress, nodeID hub.NodeID) *MsgUnjailNode {
+	return &MsgUnjailNode{
+		From:   from,
+		NodeID: nodeID,
+	}
+}
+
+func (m MsgUnjailNode) Route() string { return RouterKey }
+func (m MsgUnjailNode) Type() string  { return "unjail_node" }
+
+func (m MsgUnjailNode) ValidateBasic() sdk.Error {
+	if m.From == nil || m.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+
+	return nil
+}
+
+func (m MsgUnjailNode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgUnjailNode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.From}
+}
@@ -0,0 +1,15 @@
+package types
+
+const (
+	// ModuleName is the name of the vpn module
+	ModuleName = "vpn"
+
+	// StoreKey is the default store key for the vpn module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the vpn module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the vpn module
+	QuerierRoute = ModuleName
+)
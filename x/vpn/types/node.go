@@ -0,0 +1,39 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// Node statuses
+const (
+	NodeStatusActive = "active"
+	NodeStatusJailed = "jailed"
+)
+
+// Node is a VPN node registered with the hub
+type Node struct {
+	ID            hub.NodeID     `json:"id"`
+	Owner         sdk.AccAddress `json:"owner"`
+	Type          string         `json:"type"`
+	Version       string         `json:"version"`
+	Moniker       string         `json:"moniker"`
+	PricesPerGB   sdk.Coins      `json:"prices_per_gb"`
+	InternetSpeed hub.Bandwidth  `json:"internet_speed"`
+	Encryption    string         `json:"encryption"`
+	Deposit       sdk.Coins      `json:"deposit"`
+	Status        string         `json:"status"`
+}
+
+// ParseNodeID parses a node ID from its string (REST path/query) form
+func ParseNodeID(s string) (hub.NodeID, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return hub.NodeID{}, err
+	}
+
+	return hub.NewNodeID(id), nil
+}
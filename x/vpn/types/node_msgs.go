@@ -0,0 +1,204 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const maxMonikerLength = 128
+
+var (
+	_ sdk.Msg = (*MsgRegisterNode)(nil)
+	_ sdk.Msg = (*MsgUpdateNodeInfo)(nil)
+	_ sdk.Msg = (*MsgDeregisterNode)(nil)
+)
+
+// MsgRegisterNode registers a new VPN node with the hub
+type MsgRegisterNode struct {
+	From          sdk.AccAddress `json:"from"`
+	NodeType      string         `json:"type"`
+	Version       string         `json:"version"`
+	Moniker       string         `json:"moniker"`
+	PricesPerGB   sdk.Coins      `json:"prices_per_gb"`
+	Deposit       sdk.Coins      `json:"deposit"`
+	InternetSpeed hub.Bandwidth  `json:"internet_speed"`
+	Encryption    string         `json:"encryption"`
+}
+
+// NewMsgRegisterNode returns a new MsgRegisterNode
+func NewMsgRegisterNode(from sdk.AccAddress, nodeType, version, moniker string,
+	pricesPerGB, deposit sdk.Coins, internetSpeed hub.Bandwidth, encryption string) *MsgRegisterNode {
+	return &MsgRegisterNode{
+		From:          from,
+		NodeType:      nodeType,
+		Version:       version,
+		Moniker:       moniker,
+		PricesPerGB:   pricesPerGB,
+		Deposit:       deposit,
+		InternetSpeed: internetSpeed,
+		Encryption:    encryption,
+	}
+}
+
+func (m MsgRegisterNode) Route() string { return RouterKey }
+func (m MsgRegisterNode) Type() string  { return "register_node" }
+
+func (m MsgRegisterNode) ValidateBasic() sdk.Error {
+	if m.From == nil || m.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if m.NodeType == "" {
+		return ErrorInvalidField("type")
+	}
+	if m.Version == "" {
+		return ErrorInvalidField("version")
+	}
+	if len(m.Moniker) > maxMonikerLength {
+		return ErrorInvalidField("moniker")
+	}
+	if m.PricesPerGB == nil || len(m.PricesPerGB) == 0 || !m.PricesPerGB.IsValid() || !m.PricesPerGB.IsAllPositive() {
+		return ErrorInvalidField("prices_per_gb")
+	}
+	if m.Deposit == nil || len(m.Deposit) == 0 || !m.Deposit.IsValid() || !m.Deposit.IsAllPositive() {
+		return ErrorInvalidField("deposit")
+	}
+	if !m.InternetSpeed.IsAllPositive() {
+		return ErrorInvalidField("internet_speed")
+	}
+	if m.Encryption == "" {
+		return ErrorInvalidField("encryption")
+	}
+
+	return nil
+}
+
+func (m MsgRegisterNode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRegisterNode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.From}
+}
+
+// MsgUpdateNodeInfo updates the info of a previously registered VPN node.
+// Every field but From and ID is optional: a nil pointer means "leave this
+// field unchanged", while a non-nil pointer (including one pointing at a
+// zero value, e.g. an empty moniker) means "set this field to this value".
+// This distinguishes "omit" from "clear", which a plain value type cannot.
+// ValidateBasic rejects a provided but empty type, version, encryption or
+// prices_per_gb, so only moniker and internet_speed can be set to zero.
+type MsgUpdateNodeInfo struct {
+	From          sdk.AccAddress `json:"from"`
+	ID            hub.NodeID     `json:"id"`
+	NodeType      *string        `json:"type,omitempty"`
+	Version       *string        `json:"version,omitempty"`
+	Moniker       *string        `json:"moniker,omitempty"`
+	PricesPerGB   *sdk.Coins     `json:"prices_per_gb,omitempty"`
+	InternetSpeed *hub.Bandwidth `json:"internet_speed,omitempty"`
+	Encryption    *string        `json:"encryption,omitempty"`
+}
+
+// NewMsgUpdateNodeInfo returns a new MsgUpdateNodeInfo. A nil argument leaves
+// the corresponding field unchanged; pass a pointer to the zero value to
+// clear it instead.
+func NewMsgUpdateNodeInfo(from sdk.AccAddress, id hub.NodeID, nodeType, version, moniker *string,
+	pricesPerGB *sdk.Coins, internetSpeed *hub.Bandwidth, encryption *string) *MsgUpdateNodeInfo {
+	return &MsgUpdateNodeInfo{
+		From:          from,
+		ID:            id,
+		NodeType:      nodeType,
+		Version:       version,
+		Moniker:       moniker,
+		PricesPerGB:   pricesPerGB,
+		InternetSpeed: internetSpeed,
+		Encryption:    encryption,
+	}
+}
+
+func (m MsgUpdateNodeInfo) Route() string { return RouterKey }
+
+// Type returns "update_node_info_v2", marking the optional-field form of this
+// message. Only this form is registered with the codec (as
+// "vpn/MsgUpdateNodeInfo"); no codec entry for the earlier value-typed shape
+// is kept.
+func (m MsgUpdateNodeInfo) Type() string { return "update_node_info_v2" }
+
+func (m MsgUpdateNodeInfo) ValidateBasic() sdk.Error {
+	if m.From == nil || m.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if m.NodeType != nil && *m.NodeType == "" {
+		return ErrorInvalidField("type")
+	}
+	if m.Version != nil && *m.Version == "" {
+		return ErrorInvalidField("version")
+	}
+	if m.Moniker != nil && len(*m.Moniker) > maxMonikerLength {
+		return ErrorInvalidField("moniker")
+	}
+	if m.PricesPerGB != nil {
+		if len(*m.PricesPerGB) == 0 || !m.PricesPerGB.IsValid() || !m.PricesPerGB.IsAllPositive() {
+			return ErrorInvalidField("prices_per_gb")
+		}
+	}
+	if m.InternetSpeed != nil && m.InternetSpeed.IsAnyNegative() {
+		return ErrorInvalidField("internet_speed")
+	}
+	if m.Encryption != nil && *m.Encryption == "" {
+		return ErrorInvalidField("encryption")
+	}
+
+	return nil
+}
+
+// MarshalJSON omits every field that was not provided, so the signed
+// payload (and the bytes sent over the wire) reflect only the diff being
+// applied rather than the full node state.
+func (m MsgUpdateNodeInfo) MarshalJSON() ([]byte, error) {
+	type msgUpdateNodeInfoAlias MsgUpdateNodeInfo
+	return json.Marshal(msgUpdateNodeInfoAlias(m))
+}
+
+func (m MsgUpdateNodeInfo) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgUpdateNodeInfo) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.From}
+}
+
+// MsgDeregisterNode deregisters a previously registered VPN node
+type MsgDeregisterNode struct {
+	From sdk.AccAddress `json:"from"`
+	ID   hub.NodeID     `json:"id"`
+}
+
+// NewMsgDeregisterNode returns a new MsgDeregisterNode
+func NewMsgDeregisterNode(from sdk.AccAddress, id hub.NodeID) *MsgDeregisterNode {
+	return &MsgDeregisterNode{
+		From: from,
+		ID:   id,
+	}
+}
+
+func (m MsgDeregisterNode) Route() string { return RouterKey }
+func (m MsgDeregisterNode) Type() string  { return "deregister_node" }
+
+func (m MsgDeregisterNode) ValidateBasic() sdk.Error {
+	if m.From == nil || m.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+
+	return nil
+}
+
+func (m MsgDeregisterNode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgDeregisterNode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.From}
+}
@@ -20,55 +20,71 @@ func TestMsgRegisterNode_ValidateBasic(t *testing.T) {
 	}{
 		{
 			"from is nil",
-			NewMsgRegisterNode(nil, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(nil, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption"),
 			ErrorInvalidField("from"),
 		}, {
 			"from is empty",
-			NewMsgRegisterNode([]byte(""), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode([]byte(""), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption"),
 			ErrorInvalidField("from"),
 		}, {
 			"node_type is empty",
-			NewMsgRegisterNode(TestAddress1, "", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, "", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption"),
 			ErrorInvalidField("type"),
 		}, {
 			"version is empty",
-			NewMsgRegisterNode(TestAddress1, "node_type", "", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, "node_type", "", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption"),
 			ErrorInvalidField("version"),
 		}, {
 			"node_moniker length is greater than 128",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", strings.Repeat("X", 130), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", strings.Repeat("X", 130), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption"),
 			ErrorInvalidField("moniker"),
 		}, {
 			"prices_per_gb is nil",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", nil, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", nil, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption"),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is empty",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption"),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is negative",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-100)}}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-100)}}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption"),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is zero",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 0)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 0)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption"),
 			ErrorInvalidField("prices_per_gb"),
+		}, {
+			"deposit is nil",
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, nil, TestBandwidthPos1, "encryption"),
+			ErrorInvalidField("deposit"),
+		}, {
+			"deposit is empty",
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{}, TestBandwidthPos1, "encryption"),
+			ErrorInvalidField("deposit"),
+		}, {
+			"deposit is negative",
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-500)}}, TestBandwidthPos1, "encryption"),
+			ErrorInvalidField("deposit"),
+		}, {
+			"deposit is zero",
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 0)}, TestBandwidthPos1, "encryption"),
+			ErrorInvalidField("deposit"),
 		}, {
 			"internet_speed is negative",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthNeg, "encryption"),
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthNeg, "encryption"),
 			ErrorInvalidField("internet_speed"),
 		}, {
 			"internet_speed is zero",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthZero, "encryption"),
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthZero, "encryption"),
 			ErrorInvalidField("internet_speed"),
 		}, {
 			"encryption is empty",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, ""),
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, ""),
 			ErrorInvalidField("encryption"),
 		}, {
 			"valid",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption"),
 			nil,
 		},
 	}
@@ -83,27 +99,60 @@ func TestMsgRegisterNode_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgRegisterNode_GetSignBytes(t *testing.T) {
-	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
-	msgBytes, err := json.Marshal(msg)
+	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption")
+	want := sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+
+	require.Equal(t, want, msg.GetSignBytes())
+}
+
+// TestMsgRegisterNode_GetSignBytes_FieldOrderIndependent asserts that sign
+// bytes are derived from sorted-key JSON, so permuting the order in which
+// struct fields are declared can never change what gets signed.
+func TestMsgRegisterNode_GetSignBytes_FieldOrderIndependent(t *testing.T) {
+	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption")
+
+	type permutedMsgRegisterNode struct {
+		Encryption    string         `json:"encryption"`
+		InternetSpeed hub.Bandwidth  `json:"internet_speed"`
+		Deposit       sdk.Coins      `json:"deposit"`
+		PricesPerGB   sdk.Coins      `json:"prices_per_gb"`
+		Moniker       string         `json:"moniker"`
+		Version       string         `json:"version"`
+		Type          string         `json:"type"`
+		From          sdk.AccAddress `json:"from"`
+	}
+
+	permuted := permutedMsgRegisterNode{
+		From:          msg.From,
+		Type:          msg.NodeType,
+		Version:       msg.Version,
+		Moniker:       msg.Moniker,
+		PricesPerGB:   msg.PricesPerGB,
+		Deposit:       msg.Deposit,
+		InternetSpeed: msg.InternetSpeed,
+		Encryption:    msg.Encryption,
+	}
+
+	permutedBytes, err := json.Marshal(permuted)
 	if err != nil {
 		panic(err)
 	}
 
-	require.Equal(t, msgBytes, msg.GetSignBytes())
+	require.Equal(t, sdk.MustSortJSON(permutedBytes), msg.GetSignBytes())
 }
 
 func TestMsgRegisterNode_GetSigners(t *testing.T) {
-	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption")
 	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
 }
 
 func TestMsgRegisterNode_Type(t *testing.T) {
-	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption")
 	require.Equal(t, "register_node", msg.Type())
 }
 
 func TestMsgRegisterNode_Route(t *testing.T) {
-	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, TestBandwidthPos1, "encryption")
 	require.Equal(t, RouterKey, msg.Route())
 }
 
@@ -115,55 +164,71 @@ func TestMsgUpdateNodeInfo_ValidateBasic(t *testing.T) {
 	}{
 		{
 			"from is nil",
-			NewMsgUpdateNodeInfo(nil, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(nil, hub.NewNodeID(1), strPtr("node_type"), strPtr("version"), strPtr("moniker"), coinsPtr(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), bandwidthPtr(TestBandwidthPos1), strPtr("encryption")),
 			ErrorInvalidField("from"),
 		}, {
 			"from is empty",
-			NewMsgUpdateNodeInfo([]byte(""), hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo([]byte(""), hub.NewNodeID(1), strPtr("node_type"), strPtr("version"), strPtr("moniker"), coinsPtr(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), bandwidthPtr(TestBandwidthPos1), strPtr("encryption")),
 			ErrorInvalidField("from"),
 		}, {
-			"node_moniker length is greater than 128",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", strings.Repeat("X", 130), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			"every optional field omitted",
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, nil, nil, nil, nil),
+			nil,
+		}, {
+			"clear moniker",
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, strPtr(""), nil, nil, nil),
+			nil,
+		}, {
+			"keep moniker",
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, nil, nil, nil, nil),
+			nil,
+		}, {
+			"moniker length is greater than 128",
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, strPtr(strings.Repeat("X", 130)), nil, nil, nil),
 			ErrorInvalidField("moniker"),
 		}, {
-			"prices_per_gb is nil",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", nil, TestBandwidthPos1, "encryption"),
+			"prices_per_gb omitted",
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, nil, nil, nil, nil),
 			nil,
 		}, {
 			"prices_per_gb is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, nil, coinsPtr(sdk.Coins{}), nil, nil),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is negative",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-100)}}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, nil, coinsPtr(sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-100)}}), nil, nil),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is zero",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 0)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, nil, coinsPtr(sdk.Coins{sdk.NewInt64Coin("stake", 0)}), nil, nil),
 			ErrorInvalidField("prices_per_gb"),
+		}, {
+			"internet_speed omitted",
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, nil, nil, nil, nil),
+			nil,
 		}, {
 			"internet_speed is zero",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthZero, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, nil, nil, bandwidthPtr(TestBandwidthZero), nil),
 			nil,
 		}, {
 			"internet_speed is negative",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthNeg, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, nil, nil, bandwidthPtr(TestBandwidthNeg), nil),
 			ErrorInvalidField("internet_speed"),
 		}, {
 			"encryption is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, ""),
-			nil,
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, nil, nil, nil, strPtr("")),
+			ErrorInvalidField("encryption"),
 		}, {
 			"type is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
-			nil,
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), strPtr(""), nil, nil, nil, nil, nil),
+			ErrorInvalidField("type"),
 		}, {
 			"version is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
-			nil,
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, strPtr(""), nil, nil, nil, nil),
+			ErrorInvalidField("version"),
 		}, {
 			"valid",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), strPtr("node_type"), strPtr("version"), strPtr("moniker"), coinsPtr(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), bandwidthPtr(TestBandwidthPos1), strPtr("encryption")),
 			nil,
 		},
 	}
@@ -178,27 +243,35 @@ func TestMsgUpdateNodeInfo_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgUpdateNode_GetSignBytes(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
-	msgBytes, err := json.Marshal(msg)
-	if err != nil {
-		panic(err)
-	}
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), strPtr("node_type"), strPtr("version"), strPtr("moniker"), coinsPtr(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), bandwidthPtr(TestBandwidthPos1), strPtr("encryption"))
+	want := sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 
-	require.Equal(t, msgBytes, msg.GetSignBytes())
+	require.Equal(t, want, msg.GetSignBytes())
+}
+
+// TestMsgUpdateNode_GetSignBytes_OmitsUnsetFields asserts that leaving a
+// field out of the message (nil) excludes it from the signed payload
+// entirely, rather than signing a zero value for it.
+func TestMsgUpdateNode_GetSignBytes_OmitsUnsetFields(t *testing.T) {
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), nil, nil, strPtr("new_moniker"), nil, nil, nil)
+
+	require.NotContains(t, string(msg.GetSignBytes()), "prices_per_gb")
+	require.NotContains(t, string(msg.GetSignBytes()), "internet_speed")
+	require.Contains(t, string(msg.GetSignBytes()), "new_moniker")
 }
 
 func TestMsgUpdateNode_GetSigners(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), strPtr("node_type"), strPtr("version"), strPtr("moniker"), coinsPtr(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), bandwidthPtr(TestBandwidthPos1), strPtr("encryption"))
 	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
 }
 
 func TestMsgUpdateNode_Type(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
-	require.Equal(t, "update_node_info", msg.Type())
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), strPtr("node_type"), strPtr("version"), strPtr("moniker"), coinsPtr(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), bandwidthPtr(TestBandwidthPos1), strPtr("encryption"))
+	require.Equal(t, "update_node_info_v2", msg.Type())
 }
 
 func TestMsgUpdateNode_Route(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), strPtr("node_type"), strPtr("version"), strPtr("moniker"), coinsPtr(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), bandwidthPtr(TestBandwidthPos1), strPtr("encryption"))
 	require.Equal(t, RouterKey, msg.Route())
 }
 
@@ -234,12 +307,9 @@ func TestMsgDeregisterNode_ValidateBasic(t *testing.T) {
 
 func TestMsgDeregisterNode_GetSignBytes(t *testing.T) {
 	msg := NewMsgDeregisterNode(TestAddress1, hub.NewNodeID(1))
-	msgBytes, err := json.Marshal(msg)
-	if err != nil {
-		panic(err)
-	}
+	want := sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 
-	require.Equal(t, msgBytes, msg.GetSignBytes())
+	require.Equal(t, want, msg.GetSignBytes())
 }
 
 func TestMsgDeregisterNode_GetSigners(t *testing.T) {
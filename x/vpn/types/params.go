@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Default vpn node-liveness parameters
+var (
+	DefaultMissedHeartbeatWindow  = int64(10000)
+	DefaultMissedHeartbeatsToJail = int64(9500)
+	DefaultSlashFractionDowntime  = sdk.NewDecWithPrec(1, 4)
+	DefaultHeartbeatJailDuration  = 10 * time.Minute
+)
+
+// Parameter store keys
+var (
+	KeyMissedHeartbeatWindow  = []byte("MissedHeartbeatWindow")
+	KeyMissedHeartbeatsToJail = []byte("MissedHeartbeatsToJail")
+	KeySlashFractionDowntime  = []byte("SlashFractionDowntime")
+	KeyHeartbeatJailDuration  = []byte("HeartbeatJailDuration")
+)
+
+// ParamKeyTable returns the param key table for the vpn module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params holds the tunables for node liveness tracking and slashing
+type Params struct {
+	MissedHeartbeatWindow  int64         `json:"missed_heartbeat_window"`
+	MissedHeartbeatsToJail int64         `json:"missed_heartbeats_to_jail"`
+	SlashFractionDowntime  sdk.Dec       `json:"slash_fraction_downtime"`
+	HeartbeatJailDuration  time.Duration `json:"heartbeat_jail_duration"`
+}
+
+// DefaultParams returns the default vpn module parameters
+func DefaultParams() Params {
+	return Params{
+		MissedHeartbeatWindow:  DefaultMissedHeartbeatWindow,
+		MissedHeartbeatsToJail: DefaultMissedHeartbeatsToJail,
+		SlashFractionDowntime:  DefaultSlashFractionDowntime,
+		HeartbeatJailDuration:  DefaultHeartbeatJailDuration,
+	}
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyMissedHeartbeatWindow, Value: &p.MissedHeartbeatWindow, ValidatorFn: validateMissedHeartbeatWindow},
+		{Key: KeyMissedHeartbeatsToJail, Value: &p.MissedHeartbeatsToJail, ValidatorFn: validateMissedHeartbeatsToJail},
+		{Key: KeySlashFractionDowntime, Value: &p.SlashFractionDowntime, ValidatorFn: validateSlashFractionDowntime},
+		{Key: KeyHeartbeatJailDuration, Value: &p.HeartbeatJailDuration, ValidatorFn: validateHeartbeatJailDuration},
+	}
+}
+
+func validateMissedHeartbeatWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("missed heartbeat window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMissedHeartbeatsToJail(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("missed heartbeats to jail must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDowntime(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction downtime must be in [0, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateHeartbeatJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("heartbeat jail duration must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+// Validate checks that every parameter is within a sane range
+func (p Params) Validate() error {
+	if err := validateMissedHeartbeatWindow(p.MissedHeartbeatWindow); err != nil {
+		return err
+	}
+	if err := validateMissedHeartbeatsToJail(p.MissedHeartbeatsToJail); err != nil {
+		return err
+	}
+	if p.MissedHeartbeatsToJail > p.MissedHeartbeatWindow {
+		return fmt.Errorf("missed heartbeats to jail must be in (0, window]: %d", p.MissedHeartbeatsToJail)
+	}
+	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
+		return err
+	}
+	if err := validateHeartbeatJailDuration(p.HeartbeatJailDuration); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+	sdkTypes "github.com/ironman0x7b2/sentinel-sdk/types"
+)
+
+// Session statuses
+const (
+	StatusActive = "active"
+	StatusEnded  = "ended"
+)
+
+// Session represents a single VPN session bound to a node and a subscription
+type Session struct {
+	ID             sdkTypes.ID    `json:"id"`
+	NodeID         hub.NodeID     `json:"node_id"`
+	SubscriptionID sdkTypes.ID    `json:"subscription_id"`
+	Subscriber     sdk.AccAddress `json:"subscriber"`
+	Status         string         `json:"status"`
+	StartTime      time.Time      `json:"start_time"`
+	EndTime        time.Time      `json:"end_time"`
+}
+
+// SessionsPage is the envelope returned by the QuerySessionsFiltered querier
+// path: the page of matching sessions plus the total count across all pages.
+type SessionsPage struct {
+	Total    int64     `json:"total"`
+	Sessions []Session `json:"sessions"`
+}
+
+// SessionsFilter narrows down the set of sessions an iteration should visit.
+// A zero-valued field means "do not filter on this dimension".
+type SessionsFilter struct {
+	Status         string    `json:"status,omitempty"`
+	NodeID         string    `json:"node_id,omitempty"`
+	SubscriptionID string    `json:"subscription_id,omitempty"`
+	Subscriber     string    `json:"subscriber,omitempty"`
+	StartTimeFrom  time.Time `json:"start_time_from,omitempty"`
+	StartTimeTo    time.Time `json:"start_time_to,omitempty"`
+}
+
+// Match reports whether the given session satisfies every dimension of the filter
+func (f SessionsFilter) Match(session Session) bool {
+	if f.Status != "" && session.Status != f.Status {
+		return false
+	}
+	if f.NodeID != "" && session.NodeID.String() != f.NodeID {
+		return false
+	}
+	if f.SubscriptionID != "" && session.SubscriptionID.String() != f.SubscriptionID {
+		return false
+	}
+	if f.Subscriber != "" && session.Subscriber.String() != f.Subscriber {
+		return false
+	}
+	if !f.StartTimeFrom.IsZero() && session.StartTime.Before(f.StartTimeFrom) {
+		return false
+	}
+	if !f.StartTimeTo.IsZero() && session.StartTime.After(f.StartTimeTo) {
+		return false
+	}
+
+	return true
+}
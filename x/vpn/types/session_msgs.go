@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkTypes "github.com/ironman0x7b2/sentinel-sdk/types"
+	hub "github.com/sentinel-official/hub/types"
+)
+
+var (
+	_ sdk.Msg = (*MsgStartSession)(nil)
+	_ sdk.Msg = (*MsgEndSession)(nil)
+)
+
+// MsgStartSession opens a new VPN session against a registered node
+type MsgStartSession struct {
+	From   sdk.AccAddress `json:"from"`
+	NodeID hub.NodeID     `json:"node_id"`
+}
+
+// NewMsgStartSession returns a new MsgStartSession
+func NewMsgStartSession(from sdk.AccAddress, nodeID hub.NodeID) *MsgStartSession {
+	return &MsgStartSession{
+		From:   from,
+		NodeID: nodeID,
+	}
+}
+
+func (m MsgStartSession) Route() string { return RouterKey }
+func (m MsgStartSession) Type() string  { return "start_session" }
+
+func (m MsgStartSession) ValidateBasic() sdk.Error {
+	if m.From == nil || m.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+
+	return nil
+}
+
+func (m MsgStartSession) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgStartSession) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.From}
+}
+
+// MsgEndSession closes a previously started VPN session
+type MsgEndSession struct {
+	From      sdk.AccAddress `json:"from"`
+	SessionID sdkTypes.ID    `json:"session_id"`
+}
+
+// NewMsgEndSession returns a new MsgEndSession
+func NewMsgEndSession(from sdk.AccAddress, sessionID sdkTypes.ID) *MsgEndSession {
+	return &MsgEndSession{
+		From:      from,
+		SessionID: sessionID,
+	}
+}
+
+func (m MsgEndSession) Route() string { return RouterKey }
+func (m MsgEndSession) Type() string  { return "end_session" }
+
+func (m MsgEndSession) ValidateBasic() sdk.Error {
+	if m.From == nil || m.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+
+	return nil
+}
+
+func (m MsgEndSession) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgEndSession) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.From}
+}
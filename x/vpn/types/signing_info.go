@@ -0,0 +1,21 @@
+package types
+
+import (
+	"time"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// NodeSigningInfo tracks the liveness of a single VPN node, mirroring the
+// validator signing-info kept by the cosmos-sdk slashing module.
+type NodeSigningInfo struct {
+	NodeID           hub.NodeID `json:"node_id"`
+	StartHeight      int64      `json:"start_height"`
+	MissedHeartbeats int64      `json:"missed_heartbeats"`
+	JailedUntil      time.Time  `json:"jailed_until"`
+}
+
+// IsJailed reports whether the node is still serving out a jail term at ctxTime
+func (info NodeSigningInfo) IsJailed(ctxTime time.Time) bool {
+	return info.JailedUntil.After(ctxTime)
+}
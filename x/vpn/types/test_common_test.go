@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// Addresses shared by the vpn module test files
+var (
+	TestAddress1 = sdk.AccAddress([]byte("test_address_1_____"))
+	TestAddress2 = sdk.AccAddress([]byte("test_address_2_____"))
+)
+
+// Bandwidth values shared by the vpn module test files
+var (
+	TestBandwidthPos1 = hub.NewBandwidth(sdk.NewInt(100), sdk.NewInt(100))
+	TestBandwidthPos2 = hub.NewBandwidth(sdk.NewInt(200), sdk.NewInt(200))
+	TestBandwidthZero = hub.NewBandwidth(sdk.ZeroInt(), sdk.ZeroInt())
+	TestBandwidthNeg  = hub.NewBandwidth(sdk.NewInt(-100), sdk.NewInt(-100))
+)
+
+// strPtr, coinsPtr and bandwidthPtr let table-driven tests build the
+// optional fields of MsgUpdateNodeInfo inline, distinguishing a provided
+// value (possibly the zero value, to clear a field) from an omitted one (nil).
+func strPtr(s string) *string                    { return &s }
+func coinsPtr(c sdk.Coins) *sdk.Coins             { return &c }
+func bandwidthPtr(b hub.Bandwidth) *hub.Bandwidth { return &b }